@@ -1,13 +1,60 @@
 package main
 
 import (
+	"flag"
 	"log"
+	"strings"
 
-	"server/ui"
+	"github.com/nacin/nacin-os/pkg/config"
+	"github.com/nacin/nacin-os/pkg/system"
+	"github.com/nacin/nacin-os/pkg/ui"
 )
 
 func main() {
-	if err := ui.NewUI().Run(); err != nil {
+	serve := flag.String("serve", "", "run headless and expose /metrics.json on this address (e.g. :9191), for --connect peers to poll")
+	connect := flag.String("connect", "", "comma-separated host:port list of --serve agents to aggregate into one dashboard")
+	prometheusAddr := flag.String("prometheus", "", "also expose Prometheus-format metrics on this address (e.g. :9090) alongside the dashboard")
+	showUsers := flag.Bool("show-users", false, "include the logged-in user count in the uptime line (needs utmp access)")
+	ipv6 := flag.Bool("ipv6", false, "include IPv6 addresses in the network panel alongside IPv4")
+	tempMax := flag.Bool("temp-max-core", false, "aggregate multi-core CPU temperature by max instead of average")
+	configPath := flag.String("config", "", "path to a TOML file describing panels, refresh rate, and color scheme (defaults to today's layout)")
+	flag.Parse()
+
+	if *prometheusAddr != "" {
+		go func() {
+			log.Printf("serving Prometheus metrics on %s", *prometheusAddr)
+			if err := system.ServePrometheus(*prometheusAddr); err != nil {
+				log.Printf("prometheus exporter error: %v", err)
+			}
+		}()
+	}
+
+	if *serve != "" {
+		log.Printf("serving metrics on %s", *serve)
+		if err := system.ServeMetrics(*serve, *showUsers, *ipv6, *tempMax); err != nil {
+			log.Fatalf("agent error: %v", err)
+		}
+		return
+	}
+
+	cfg := config.Default()
+	if *configPath != "" {
+		loaded, err := config.Load(*configPath)
+		if err != nil {
+			log.Fatalf("loading config: %v", err)
+		}
+		cfg = loaded
+	}
+
+	var collectors []system.Collector
+	for _, host := range strings.Split(*connect, ",") {
+		if host = strings.TrimSpace(host); host != "" {
+			collectors = append(collectors, system.NewRemoteCollector(host))
+		}
+	}
+
+	app := ui.NewUIWithConfig(cfg, collectors, *showUsers, *ipv6, *tempMax)
+	if err := app.Run(); err != nil {
 		log.Fatalf("Application error: %v", err)
 	}
 }
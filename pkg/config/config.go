@@ -0,0 +1,126 @@
+// Package config loads the TOML file that drives which panels the dashboard
+// shows, how often it refreshes, and how it's themed, so each exam center
+// can customize the look without recompiling.
+package config
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/knadh/koanf/parsers/toml"
+	"github.com/knadh/koanf/providers/file"
+	"github.com/knadh/koanf/v2"
+)
+
+// Panel identifies one of the dashboard's renderable sections.
+type Panel string
+
+const (
+	PanelHeader   Panel = "header"
+	PanelStats    Panel = "stats"
+	PanelIPs      Panel = "ips"
+	PanelTemps    Panel = "temps"
+	PanelLoad     Panel = "load"
+	PanelTopProcs Panel = "top-procs"
+	PanelNetwork  Panel = "network"
+)
+
+// Scheme selects the dashboard's color palette.
+type Scheme string
+
+const (
+	SchemeYellowDark   Scheme = "yellow-dark"
+	SchemeMono         Scheme = "mono"
+	SchemeHighContrast Scheme = "high-contrast"
+)
+
+// PanelLayout places one panel in the dashboard. Panels sharing the same Row
+// are laid out as side-by-side columns (in list order) within one flex row,
+// sized by their relative Weight; RowWeight is that row's own proportional
+// height and only needs to be set on one panel per row (the largest value in
+// the row wins).
+type PanelLayout struct {
+	Name      Panel `koanf:"name"`
+	Row       int   `koanf:"row"`
+	RowWeight int   `koanf:"row_weight"`
+	Weight    int   `koanf:"weight"`
+}
+
+// Config describes the dashboard's panel list, refresh interval, color
+// scheme, and title. Load a file with Load, or call Default for today's
+// hard-coded layout.
+type Config struct {
+	Title     string        `koanf:"title"`
+	RefreshMS int           `koanf:"refresh_ms"`
+	Colors    Scheme        `koanf:"colors"`
+	Panels    []PanelLayout `koanf:"panels"`
+}
+
+// Refresh returns RefreshMS as a time.Duration.
+func (c *Config) Refresh() time.Duration {
+	return time.Duration(c.RefreshMS) * time.Millisecond
+}
+
+// Row is one horizontal row of the dashboard: one or more panels laid out as
+// columns, plus the row's own proportional height.
+type Row struct {
+	Weight int
+	Panels []PanelLayout
+}
+
+// Rows groups c.Panels by Row number into the ui package's flex rows,
+// preserving the order each row number is first seen in Panels.
+func (c *Config) Rows() []Row {
+	var order []int
+	byRow := map[int]*Row{}
+	for _, p := range c.Panels {
+		row, ok := byRow[p.Row]
+		if !ok {
+			row = &Row{}
+			byRow[p.Row] = row
+			order = append(order, p.Row)
+		}
+		if p.RowWeight > row.Weight {
+			row.Weight = p.RowWeight
+		}
+		row.Panels = append(row.Panels, p)
+	}
+
+	rows := make([]Row, len(order))
+	for i, n := range order {
+		rows[i] = *byRow[n]
+	}
+	return rows
+}
+
+// Default returns the configuration matching the dashboard's historical
+// layout: a full-width header row, then IP addresses alongside the stats
+// column in a second row, then the network throughput panel.
+func Default() *Config {
+	return &Config{
+		Title:     "NACIN EXAM SERVER",
+		RefreshMS: 900,
+		Colors:    SchemeYellowDark,
+		Panels: []PanelLayout{
+			{Name: PanelHeader, Row: 0, RowWeight: 12, Weight: 1},
+			{Name: PanelIPs, Row: 1, RowWeight: 14, Weight: 2},
+			{Name: PanelStats, Row: 1, Weight: 1},
+			{Name: PanelNetwork, Row: 2, RowWeight: 10, Weight: 1},
+		},
+	}
+}
+
+// Load reads a TOML config file at path, starting from Default() so any
+// field the file omits keeps its historical value.
+func Load(path string) (*Config, error) {
+	cfg := Default()
+
+	k := koanf.New(".")
+	if err := k.Load(file.Provider(path), toml.Parser()); err != nil {
+		return nil, fmt.Errorf("loading config %s: %w", path, err)
+	}
+	if err := k.Unmarshal("", cfg); err != nil {
+		return nil, fmt.Errorf("parsing config %s: %w", path, err)
+	}
+	return cfg, nil
+}
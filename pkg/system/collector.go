@@ -0,0 +1,96 @@
+package system
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"time"
+)
+
+// Collector retrieves a SystemInfo snapshot, either from the local machine
+// or from a remote agent exposing ServeMetrics over HTTP.
+type Collector interface {
+	// Host returns the label used to identify this collector's data in the UI.
+	Host() string
+	// Collect returns the latest SystemInfo snapshot.
+	Collect() (SystemInfo, error)
+}
+
+// localCollector reads SystemInfo directly from the current machine.
+type localCollector struct {
+	host             string
+	showUsers        bool
+	includeIPv6      bool
+	aggregateTempMax bool
+	netSampler       *NetSampler
+}
+
+// NewLocalCollector returns a Collector backed by GetSystemInfo. showUsers,
+// includeIPv6, and aggregateTempMax are forwarded to GetSystemInfo on every
+// collection, which gets its own NetSampler so its network rates aren't
+// disturbed by any other collector or endpoint's polling.
+func NewLocalCollector(host string, showUsers, includeIPv6, aggregateTempMax bool) Collector {
+	return &localCollector{
+		host:             host,
+		showUsers:        showUsers,
+		includeIPv6:      includeIPv6,
+		aggregateTempMax: aggregateTempMax,
+		netSampler:       NewNetSampler(),
+	}
+}
+
+func (c *localCollector) Host() string { return c.host }
+
+func (c *localCollector) Collect() (SystemInfo, error) {
+	return GetSystemInfo(c.showUsers, c.includeIPv6, c.aggregateTempMax, c.netSampler), nil
+}
+
+// remoteCollector fetches SystemInfo from a peer agent's /metrics.json
+// endpoint, as started by ServeMetrics.
+type remoteCollector struct {
+	addr   string
+	client *http.Client
+}
+
+// NewRemoteCollector returns a Collector that polls the agent listening on
+// addr (host:port).
+func NewRemoteCollector(addr string) Collector {
+	return &remoteCollector{
+		addr:   addr,
+		client: &http.Client{Timeout: 3 * time.Second},
+	}
+}
+
+func (c *remoteCollector) Host() string { return c.addr }
+
+func (c *remoteCollector) Collect() (SystemInfo, error) {
+	resp, err := c.client.Get(fmt.Sprintf("http://%s/metrics.json", c.addr))
+	if err != nil {
+		return SystemInfo{}, fmt.Errorf("fetching metrics from %s: %w", c.addr, err)
+	}
+	defer resp.Body.Close()
+
+	var info SystemInfo
+	if err := json.NewDecoder(resp.Body).Decode(&info); err != nil {
+		return SystemInfo{}, fmt.Errorf("decoding metrics from %s: %w", c.addr, err)
+	}
+	return info, nil
+}
+
+// ServeMetrics runs a headless agent on addr that exposes the local
+// SystemInfo as JSON at /metrics.json for remote collectors to poll. It
+// blocks until the HTTP server stops. showUsers, includeIPv6, and
+// aggregateTempMax are forwarded to GetSystemInfo on every request, which
+// all share one NetSampler scoped to this agent's serving loop.
+func ServeMetrics(addr string, showUsers, includeIPv6, aggregateTempMax bool) error {
+	netSampler := NewNetSampler()
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("/metrics.json", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		if err := json.NewEncoder(w).Encode(GetSystemInfo(showUsers, includeIPv6, aggregateTempMax, netSampler)); err != nil {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+		}
+	})
+	return http.ListenAndServe(addr, mux)
+}
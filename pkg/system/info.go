@@ -10,27 +10,47 @@ import (
 
 	"github.com/shirou/gopsutil/v3/cpu"
 	"github.com/shirou/gopsutil/v3/host"
+	"github.com/shirou/gopsutil/v3/load"
 	"github.com/shirou/gopsutil/v3/mem"
+	"github.com/shirou/gopsutil/v3/process"
 )
 
 // SystemInfo holds all system information
 type SystemInfo struct {
-	CPUInfo     string
-	GPUInfo     string
-	MemoryInfo  string
-	RAMUsage    string
-	CPUUsage    string
-	UptimeInfo  string
-	IPAddresses []string
-	CPUTemp     string
-	GPUTemp     string
-	NetworkInfo []string
-	Platform    string
-	OSInfo      string
+	CPUInfo          string
+	GPUInfo          string
+	MemoryInfo       string
+	RAMUsage         string
+	CPUUsage         string
+	UptimeInfo       string
+	IPAddresses      []string
+	CPUTemp          string
+	CPUTempPerCore   []int
+	GPUTemp          string
+	NetworkInfo      []string
+	Platform         string
+	OSInfo           string
+	LoadAvg1         float64
+	LoadAvg5         float64
+	LoadAvg15        float64
+	NumUsers         int
+	NumProcs         int
+	Interfaces       []InterfaceStat
+	CPUUsagePerCore  []float64
+	MemoryUsedBytes  uint64
+	MemoryTotalBytes uint64
+	UptimeSeconds    uint64
 }
 
-// GetSystemInfo returns current system information
-func GetSystemInfo() SystemInfo {
+// GetSystemInfo returns current system information. showUsers gates the
+// logged-in-user count, since it needs utmp access that isn't available (or
+// is slow) on some systems. includeIPv6 gates whether Interfaces reports
+// IPv6 addresses alongside IPv4. aggregateTempMax selects how multi-core CPU
+// temperature readings are collapsed into the headline CPUTemp: the max
+// reading when true, the average when false. netSampler tracks network
+// throughput between calls and must belong to the caller's own polling
+// loop — sharing one across independent loops corrupts their rates.
+func GetSystemInfo(showUsers, includeIPv6, aggregateTempMax bool, netSampler *NetSampler) SystemInfo {
 	info := SystemInfo{}
 
 	// Get platform information
@@ -50,19 +70,24 @@ func GetSystemInfo() SystemInfo {
 		info.CPUInfo = "CPU information unavailable"
 	}
 
-	// Get CPU usage
+	// Get CPU usage, overall and per-core
 	cpuPercent, err := cpu.Percent(100*time.Millisecond, false)
 	if err == nil && len(cpuPercent) > 0 {
 		info.CPUUsage = fmt.Sprintf("CPU Usage: %2d%%", int(cpuPercent[0]))
 	} else {
 		info.CPUUsage = "CPU Usage: N/A"
 	}
+	if perCore, err := cpu.Percent(100*time.Millisecond, true); err == nil {
+		info.CPUUsagePerCore = perCore
+	}
 
 	// Get memory information
 	memInfo, err := mem.VirtualMemory()
 	if err == nil {
 		info.MemoryInfo = fmt.Sprintf("%d GB System Memory (%.1f GB Used)", memInfo.Total/(1024*1024*1024), float64(memInfo.Used)/(1024*1024*1024))
 		info.RAMUsage = fmt.Sprintf("RAM Usage: %2d%%", int(memInfo.UsedPercent))
+		info.MemoryUsedBytes = memInfo.Used
+		info.MemoryTotalBytes = memInfo.Total
 	} else {
 		info.MemoryInfo = "Memory information unavailable"
 		info.RAMUsage = "RAM Usage: N/A"
@@ -70,37 +95,84 @@ func GetSystemInfo() SystemInfo {
 
 	// Get uptime information
 	if err == nil {
+		info.UptimeSeconds = hostInfo.Uptime
 		uptime := time.Duration(hostInfo.Uptime) * time.Second
 		info.UptimeInfo = fmt.Sprintf("Uptime: %s", formatDuration(uptime))
 	} else {
 		info.UptimeInfo = "Uptime information unavailable"
 	}
 
-	// Get GPU information and temperature
+	// Get GPU information and temperature. GPUTemp is left blank when no
+	// sensor is available so the UI can hide the line instead of showing a
+	// made-up value.
 	gpuInfo, gpuTemp := getGPUInfo()
 	info.GPUInfo = gpuInfo
 	if gpuTemp > 0 {
 		info.GPUTemp = fmt.Sprintf("GPU Temp: %d°C", gpuTemp)
-	} else {
-		// Fallback to simulated values if real data not available
-		info.GPUTemp = fmt.Sprintf("GPU Temp: %d°C", int(60.0+10.0*float64(time.Now().Second()%10)/10.0))
 	}
 
-	// Get CPU temperature
-	cpuTemp := getCPUTemperature()
-	if cpuTemp > 0 {
+	// Get CPU temperature. Same rule as GPUTemp above: blank when unknown.
+	if cpuTemp, perCore := getCPUTemperature(aggregateTempMax); cpuTemp > 0 {
 		info.CPUTemp = fmt.Sprintf("CPU Temp: %d°C", cpuTemp)
-	} else {
-		// Fallback to simulated values if real data not available
-		info.CPUTemp = fmt.Sprintf("CPU Temp: %d°C", int(45.0+5.0*float64(time.Now().Second()%10)/10.0))
+		info.CPUTempPerCore = perCore
+	}
+
+	// Get load average and process/user counts
+	if avg, err := load.Avg(); err == nil {
+		info.LoadAvg1, info.LoadAvg5, info.LoadAvg15 = avg.Load1, avg.Load5, avg.Load15
+	}
+	if procs, err := process.Processes(); err == nil {
+		info.NumProcs = len(procs)
+	}
+	if showUsers {
+		if users, err := host.Users(); err == nil {
+			info.NumUsers = len(users)
+		}
 	}
 
 	// Get IP addresses
 	info.IPAddresses = getIPAddresses()
 
+	// Get per-interface addresses and throughput
+	if stats, err := netSampler.getInterfaceStats(includeIPv6); err == nil {
+		info.Interfaces = stats
+	}
+
 	return info
 }
 
+// FormatUptimeLine renders uptime, user count, and load averages in the
+// classic `uptime(1)` style, e.g. "up 3d 4h, 5 users, load 0.42 0.55 0.61".
+// The user count is omitted when showUsers is false, matching the
+// --show-users gate on GetSystemInfo.
+func FormatUptimeLine(info SystemInfo, showUsers bool) string {
+	parts := []string{"up " + formatUptimeShort(info.UptimeInfo)}
+	if showUsers {
+		plural := "s"
+		if info.NumUsers == 1 {
+			plural = ""
+		}
+		parts = append(parts, fmt.Sprintf("%d user%s", info.NumUsers, plural))
+	}
+	parts = append(parts, fmt.Sprintf("load %.2f %.2f %.2f", info.LoadAvg1, info.LoadAvg5, info.LoadAvg15))
+	return strings.Join(parts, ", ")
+}
+
+// formatUptimeShort turns the "Uptime: N hours NN minutes NN seconds" string
+// built by formatDuration into the compact "Nd Nh" form uptime(1) uses.
+func formatUptimeShort(uptimeInfo string) string {
+	var hours, mins, secs int
+	if _, err := fmt.Sscanf(uptimeInfo, "Uptime: %d hours %d minutes %d seconds", &hours, &mins, &secs); err != nil {
+		return "unknown"
+	}
+	days := hours / 24
+	hours = hours % 24
+	if days > 0 {
+		return fmt.Sprintf("%dd %dh", days, hours)
+	}
+	return fmt.Sprintf("%dh %dm", hours, mins)
+}
+
 // formatDuration formats uptime in a human-readable format
 func formatDuration(d time.Duration) string {
 	hours := int(d.Hours())
@@ -188,28 +260,21 @@ func getGPUInfo() (string, int) {
 	return gpuInfo, temperature
 }
 
-// getCPUTemperature attempts to get CPU temperature using platform-specific methods
-func getCPUTemperature() int {
-	var temperature int = 0
+// getCPUTemperature is implemented per-platform in temp_*.go.
 
-	if runtime.GOOS == "linux" {
-		// Try to read from sensors on Linux
-		cmd := exec.Command("sensors", "-j")
-		output, err := cmd.Output()
-		if err == nil {
-			// This is a simplified approach - in a real app you'd parse the JSON
-			if strings.Contains(string(output), "temp") {
-				// Just a placeholder - real implementation would parse the JSON properly
-				temperature = 50 // Placeholder value
-			}
+// NvidiaGPUInfo returns the first NVIDIA GPU's name via nvidia-smi, or an
+// empty string if nvidia-smi isn't installed or no GPU is found. It's meant
+// to be composed with a fallback monitor (e.g. ui.AltMonitor against a
+// constant "Integrated Graphics" monitor) rather than used standalone.
+func NvidiaGPUInfo() string {
+	output, err := exec.Command("nvidia-smi", "--query-gpu=name", "--format=csv,noheader,nounits").Output()
+	if err != nil {
+		return ""
+	}
+	for _, line := range strings.Split(string(output), "\n") {
+		if line = strings.TrimSpace(line); line != "" {
+			return line
 		}
-	} else if runtime.GOOS == "darwin" {
-		// macOS temperature via SMC would require a C binding or external tool
-		// This is just a placeholder
-	} else if runtime.GOOS == "windows" {
-		// Windows would use WMI queries
-		// This is just a placeholder
 	}
-
-	return temperature
+	return ""
 }
@@ -0,0 +1,128 @@
+package system
+
+import (
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+)
+
+// metrics holds the Prometheus collectors exposed by ServePrometheus.
+var metrics = struct {
+	cpuUsage     prometheus.Gauge
+	cpuUsageCore *prometheus.GaugeVec
+	ramUsedBytes prometheus.Gauge
+	ramTotal     prometheus.Gauge
+	cpuTemp      prometheus.Gauge
+	gpuTemp      prometheus.Gauge
+	uptime       prometheus.Gauge
+	interfaceIP  *prometheus.GaugeVec
+}{
+	cpuUsage: promauto.NewGauge(prometheus.GaugeOpts{
+		Name: "nacin_cpu_usage_percent",
+		Help: "Current CPU utilisation as a percentage.",
+	}),
+	cpuUsageCore: promauto.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "nacin_cpu_usage_per_core_percent",
+		Help: "Current CPU utilisation as a percentage, per core.",
+	}, []string{"core"}),
+	ramUsedBytes: promauto.NewGauge(prometheus.GaugeOpts{
+		Name: "nacin_ram_used_bytes",
+		Help: "Current RAM used, in bytes.",
+	}),
+	ramTotal: promauto.NewGauge(prometheus.GaugeOpts{
+		Name: "nacin_ram_total_bytes",
+		Help: "Total installed RAM, in bytes.",
+	}),
+	cpuTemp: promauto.NewGauge(prometheus.GaugeOpts{
+		Name: "nacin_cpu_temp_celsius",
+		Help: "CPU package temperature in degrees Celsius.",
+	}),
+	gpuTemp: promauto.NewGauge(prometheus.GaugeOpts{
+		Name: "nacin_gpu_temp_celsius",
+		Help: "GPU temperature in degrees Celsius.",
+	}),
+	uptime: promauto.NewGauge(prometheus.GaugeOpts{
+		Name: "nacin_uptime_seconds",
+		Help: "System uptime in seconds.",
+	}),
+	interfaceIP: promauto.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "nacin_interface_ip_count",
+		Help: "Number of IPv4 addresses bound to each network interface.",
+	}, []string{"interface"}),
+}
+
+// promNetSampler tracks network throughput across scrapes. It's scoped to
+// the Prometheus exporter's own polling loop, separate from any UI
+// collector or agent endpoint also calling GetSystemInfo in this process.
+var promNetSampler = NewNetSampler()
+
+// collectPrometheusMetrics refreshes the package-level gauges from a fresh
+// SystemInfo snapshot, so every scrape sees current values.
+func collectPrometheusMetrics() {
+	info := GetSystemInfo(false, false, false, promNetSampler)
+
+	var pct int
+	if _, err := fmt.Sscanf(lastField(info.CPUUsage), "%d%%", &pct); err == nil {
+		metrics.cpuUsage.Set(float64(pct))
+	}
+	metrics.ramUsedBytes.Set(float64(info.MemoryUsedBytes))
+	metrics.ramTotal.Set(float64(info.MemoryTotalBytes))
+	metrics.uptime.Set(float64(info.UptimeSeconds))
+
+	for core, usage := range info.CPUUsagePerCore {
+		metrics.cpuUsageCore.WithLabelValues(strconv.Itoa(core)).Set(usage)
+	}
+
+	var temp int
+	if _, err := fmt.Sscanf(lastField(info.CPUTemp), "%d°C", &temp); err == nil {
+		metrics.cpuTemp.Set(float64(temp))
+	}
+	if _, err := fmt.Sscanf(lastField(info.GPUTemp), "%d°C", &temp); err == nil {
+		metrics.gpuTemp.Set(float64(temp))
+	}
+
+	metrics.interfaceIP.Reset()
+	counts := map[string]int{}
+	for _, addr := range info.IPAddresses {
+		if open := strings.LastIndex(addr, "("); open >= 0 && strings.HasSuffix(addr, ")") {
+			counts[addr[open+1:len(addr)-1]]++
+		}
+	}
+	for iface, n := range counts {
+		metrics.interfaceIP.WithLabelValues(iface).Set(float64(n))
+	}
+}
+
+// lastField returns the last whitespace-separated field of s, e.g. "52%" out
+// of "CPU Usage: 52%", to keep the Sscanf formats above simple.
+func lastField(s string) string {
+	fields := strings.Fields(s)
+	if len(fields) == 0 {
+		return ""
+	}
+	return fields[len(fields)-1]
+}
+
+// ServePrometheus starts an HTTP server on addr exposing CPU (overall and
+// per-core), RAM, temperature, uptime, and per-interface IP gauges at
+// /metrics in Prometheus text format. It blocks until the server stops.
+func ServePrometheus(addr string) error {
+	mux := http.NewServeMux()
+	mux.Handle("/metrics", promMetricsHandler())
+	return http.ListenAndServe(addr, mux)
+}
+
+// promMetricsHandler wraps promhttp.Handler with a fresh sample on every
+// scrape, so Prometheus never sees stale gauges.
+func promMetricsHandler() http.Handler {
+	handler := promhttp.Handler()
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		collectPrometheusMetrics()
+		handler.ServeHTTP(w, r)
+	})
+}
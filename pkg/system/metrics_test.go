@@ -0,0 +1,38 @@
+package system
+
+import (
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+func TestPromMetricsHandler(t *testing.T) {
+	req := httptest.NewRequest("GET", "/metrics", nil)
+	rec := httptest.NewRecorder()
+
+	promMetricsHandler().ServeHTTP(rec, req)
+
+	if rec.Code != 200 {
+		t.Fatalf("expected status 200, got %d", rec.Code)
+	}
+
+	body := rec.Body.String()
+	for _, name := range []string{"nacin_cpu_usage_percent", "nacin_ram_used_bytes", "nacin_uptime_seconds"} {
+		if !strings.Contains(body, name) {
+			t.Errorf("expected metrics output to contain %q, got:\n%s", name, body)
+		}
+	}
+}
+
+func TestLastField(t *testing.T) {
+	cases := map[string]string{
+		"CPU Usage: 42%": "42%",
+		"CPU Temp: 52°C": "52°C",
+		"":               "",
+	}
+	for in, want := range cases {
+		if got := lastField(in); got != want {
+			t.Errorf("lastField(%q) = %q, want %q", in, got, want)
+		}
+	}
+}
@@ -0,0 +1,117 @@
+package system
+
+import (
+	"net"
+	"sync"
+	"time"
+
+	gonet "github.com/shirou/gopsutil/v3/net"
+)
+
+// InterfaceStat is one non-loopback network interface's addresses and
+// throughput, sampled between successive calls on the same NetSampler.
+type InterfaceStat struct {
+	Name   string
+	MAC    string
+	IPv4   []string
+	IPv6   []string
+	RxRate float64 // bytes/sec since the previous sample
+	TxRate float64 // bytes/sec since the previous sample
+}
+
+// NetSampler caches the previous IOCounters reading so getInterfaceStats can
+// compute a rate, the same way gopsutil's cpu.Percent caches its previous
+// sample between calls. It belongs to exactly one polling loop (a
+// collector, an agent's HTTP handler, the Prometheus exporter); sharing one
+// NetSampler across independent loops would have each one's tick clobber the
+// others' "previous sample" and corrupt the elapsed time the rate is over.
+type NetSampler struct {
+	mu       sync.Mutex
+	at       time.Time
+	counters map[string]gonet.IOCountersStat
+}
+
+// NewNetSampler returns a NetSampler with no prior reading, so its first
+// getInterfaceStats call reports zero rates.
+func NewNetSampler() *NetSampler {
+	return &NetSampler{counters: map[string]gonet.IOCountersStat{}}
+}
+
+// getInterfaceStats returns per-interface addresses and throughput for every
+// non-loopback interface, rating against s's previous sample. includeIPv6
+// gates whether IPv6 addresses are included, matching the --ipv6 flag.
+func (s *NetSampler) getInterfaceStats(includeIPv6 bool) ([]InterfaceStat, error) {
+	ifaces, err := net.Interfaces()
+	if err != nil {
+		return nil, err
+	}
+
+	counters, err := gonet.IOCounters(true)
+	if err != nil {
+		return nil, err
+	}
+	byName := make(map[string]gonet.IOCountersStat, len(counters))
+	for _, c := range counters {
+		byName[c.Name] = c
+	}
+
+	s.mu.Lock()
+	now := time.Now()
+	elapsed := now.Sub(s.at).Seconds()
+	prev := s.counters
+	s.counters = byName
+	s.at = now
+	s.mu.Unlock()
+
+	var stats []InterfaceStat
+	for _, iface := range ifaces {
+		if iface.Flags&net.FlagLoopback != 0 {
+			continue
+		}
+
+		stat := InterfaceStat{Name: iface.Name, MAC: iface.HardwareAddr.String()}
+
+		if addrs, err := iface.Addrs(); err == nil {
+			for _, addr := range addrs {
+				var ip net.IP
+				switch v := addr.(type) {
+				case *net.IPNet:
+					ip = v.IP
+				case *net.IPAddr:
+					ip = v.IP
+				}
+				if ip == nil || ip.IsLoopback() {
+					continue
+				}
+				if ipv4 := ip.To4(); ipv4 != nil {
+					stat.IPv4 = append(stat.IPv4, ipv4.String())
+				} else if includeIPv6 {
+					stat.IPv6 = append(stat.IPv6, ip.String())
+				}
+			}
+		}
+
+		if elapsed > 0 {
+			if cur, ok := byName[iface.Name]; ok {
+				if old, ok := prev[iface.Name]; ok {
+					stat.RxRate = rateSince(old.BytesRecv, cur.BytesRecv, elapsed)
+					stat.TxRate = rateSince(old.BytesSent, cur.BytesSent, elapsed)
+				}
+			}
+		}
+
+		stats = append(stats, stat)
+	}
+
+	return stats, nil
+}
+
+// rateSince returns the bytes/sec rate between two cumulative counters,
+// treating a counter that went backwards (interface reset) as no traffic
+// rather than underflowing.
+func rateSince(old, cur uint64, elapsedSeconds float64) float64 {
+	if cur < old {
+		return 0
+	}
+	return float64(cur-old) / elapsedSeconds
+}
@@ -0,0 +1,57 @@
+//go:build darwin
+
+package system
+
+import (
+	"os/exec"
+	"strconv"
+	"strings"
+)
+
+// getCPUTemperature shells out to powermetrics (needs sudo, so this silently
+// falls back to osx-cpu-temp when it fails) to read the CPU die temperature.
+// Neither tool ships with macOS, so both are best-effort. Only one combined
+// die reading is available, so aggregateMax is ignored and the per-core
+// slice is just that single reading.
+func getCPUTemperature(_ bool) (int, []int) {
+	if temp, ok := readPowermetrics(); ok {
+		return temp, []int{temp}
+	}
+	if temp := readOSXCPUTemp(); temp > 0 {
+		return temp, []int{temp}
+	}
+	return 0, nil
+}
+
+func readPowermetrics() (int, bool) {
+	output, err := exec.Command("powermetrics", "--samplers", "smc", "-i1", "-n1").Output()
+	if err != nil {
+		return 0, false
+	}
+	for _, line := range strings.Split(string(output), "\n") {
+		if !strings.Contains(line, "CPU die temperature") {
+			continue
+		}
+		fields := strings.Fields(line)
+		for _, f := range fields {
+			f = strings.TrimSuffix(f, "C")
+			if v, err := strconv.ParseFloat(f, 64); err == nil {
+				return int(v), true
+			}
+		}
+	}
+	return 0, false
+}
+
+func readOSXCPUTemp() int {
+	output, err := exec.Command("osx-cpu-temp").Output()
+	if err != nil {
+		return 0
+	}
+	text := strings.TrimSpace(strings.TrimSuffix(strings.TrimSpace(string(output)), "°C"))
+	v, err := strconv.ParseFloat(text, 64)
+	if err != nil {
+		return 0
+	}
+	return int(v)
+}
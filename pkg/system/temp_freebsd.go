@@ -0,0 +1,52 @@
+//go:build freebsd
+
+package system
+
+import (
+	"os/exec"
+	"strconv"
+	"strings"
+)
+
+// getCPUTemperature reads dev.cpu.N.temperature across every core reported
+// by sysctl, which FreeBSD exposes in whole Celsius via coretemp(4) or
+// amdtemp(4), and aggregates them by max (if aggregateMax) or average.
+func getCPUTemperature(aggregateMax bool) (int, []int) {
+	var readings []int
+	for core := 0; ; core++ {
+		output, err := exec.Command("sysctl", "-n", "dev.cpu."+strconv.Itoa(core)+".temperature").Output()
+		if err != nil {
+			break
+		}
+		text := strings.TrimSuffix(strings.TrimSpace(string(output)), "C")
+		v, err := strconv.ParseFloat(text, 64)
+		if err != nil {
+			break
+		}
+		readings = append(readings, int(v))
+	}
+
+	if len(readings) == 0 {
+		return 0, nil
+	}
+	return aggregate(readings, aggregateMax), readings
+}
+
+// aggregate collapses vals to a single headline reading: the maximum when
+// aggregateMax is set, otherwise the average.
+func aggregate(vals []int, aggregateMax bool) int {
+	if aggregateMax {
+		max := vals[0]
+		for _, v := range vals[1:] {
+			if v > max {
+				max = v
+			}
+		}
+		return max
+	}
+	sum := 0
+	for _, v := range vals {
+		sum += v
+	}
+	return sum / len(vals)
+}
@@ -0,0 +1,185 @@
+//go:build linux
+
+package system
+
+import (
+	"encoding/json"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strconv"
+	"strings"
+)
+
+// preferredTempDrivers are checked in order when a hwmon device exposes more
+// than one temperature input, so we report the package sensor rather than a
+// peripheral one. These come from hwmon*/name (the driver name), not
+// temp*_label, since coretemp/k10temp/cpu_thermal are driver names, not
+// per-input labels.
+var preferredTempDrivers = []string{"coretemp", "k10temp", "cpu_thermal"}
+
+// preferredTempLabels are per-input temp*_label values that identify the
+// whole-package sensor on drivers (e.g. hwmon-on-DIMM chips) that don't set
+// their name to one of preferredTempDrivers.
+var preferredTempLabels = []string{"Package id 0", "Tctl"}
+
+// coreTempLabelPrefix identifies a per-core sensor label, e.g. "Core 0".
+const coreTempLabelPrefix = "Core "
+
+// getCPUTemperature reads /sys/class/hwmon/hwmon*/temp*_input, preferring the
+// CPU package sensor, and falls back to parsing `sensors -j` when sysfs has
+// nothing usable. It returns a headline value aggregated by max (if
+// aggregateMax) or average across the package-level sensors, plus the
+// individual per-core readings. Per-core ("Core N") inputs are not part of
+// the headline; when there are none, the package-level readings double as
+// the per-core slice.
+func getCPUTemperature(aggregateMax bool) (int, []int) {
+	if headline, perCore, ok := readHwmonTemp(aggregateMax); ok {
+		return headline, perCore
+	}
+	if headline, perCore, ok := readSensorsJSON(aggregateMax); ok {
+		return headline, perCore
+	}
+	return 0, nil
+}
+
+// readHwmonTemp aggregates the best-matching temperature input across all
+// hwmon devices, scaling the millidegree readings down to whole Celsius.
+func readHwmonTemp(aggregateMax bool) (int, []int, bool) {
+	inputs, err := filepath.Glob("/sys/class/hwmon/hwmon*/temp*_input")
+	if err != nil || len(inputs) == 0 {
+		return 0, nil, false
+	}
+
+	var best, fallback, perCore []int
+	for _, input := range inputs {
+		raw, err := os.ReadFile(input)
+		if err != nil {
+			continue
+		}
+		milli, err := strconv.Atoi(strings.TrimSpace(string(raw)))
+		if err != nil {
+			continue
+		}
+		celsius := milli / 1000
+
+		label := readSensorLabel(strings.TrimSuffix(input, "_input") + "_label")
+		driver := readSensorLabel(filepath.Join(filepath.Dir(input), "name"))
+
+		// Per-core inputs (e.g. "Core 0") are collected separately and must
+		// never land in best: on coretemp/k10temp boards the driver name
+		// matches every one of the chip's inputs, package and per-core
+		// alike, so checking the driver alone would average the package
+		// sensor together with every core and skew the headline value.
+		if strings.HasPrefix(label, coreTempLabelPrefix) {
+			perCore = append(perCore, celsius)
+			continue
+		}
+
+		switch {
+		case isPreferredLabel(label) || isPreferredDriver(driver):
+			best = append(best, celsius)
+		default:
+			fallback = append(fallback, celsius)
+		}
+	}
+
+	readings := best
+	if len(readings) == 0 {
+		readings = fallback
+	}
+	if len(readings) == 0 {
+		return 0, nil, false
+	}
+	if len(perCore) == 0 {
+		perCore = readings
+	}
+	return aggregate(readings, aggregateMax), perCore, true
+}
+
+func readSensorLabel(path string) string {
+	raw, err := os.ReadFile(path)
+	if err != nil {
+		return ""
+	}
+	return strings.TrimSpace(string(raw))
+}
+
+func isPreferredDriver(driver string) bool {
+	for _, want := range preferredTempDrivers {
+		if strings.EqualFold(driver, want) {
+			return true
+		}
+	}
+	return false
+}
+
+func isPreferredLabel(label string) bool {
+	for _, want := range preferredTempLabels {
+		if strings.EqualFold(label, want) {
+			return true
+		}
+	}
+	return false
+}
+
+// aggregate collapses vals to a single headline reading: the maximum when
+// aggregateMax is set, otherwise the average.
+func aggregate(vals []int, aggregateMax bool) int {
+	if aggregateMax {
+		max := vals[0]
+		for _, v := range vals[1:] {
+			if v > max {
+				max = v
+			}
+		}
+		return max
+	}
+	return average(vals)
+}
+
+func average(vals []int) int {
+	sum := 0
+	for _, v := range vals {
+		sum += v
+	}
+	return sum / len(vals)
+}
+
+// sensorsOutput mirrors the bits of `sensors -j` we care about: a map of
+// chip name to a map of feature name to a map of sub-feature name to value.
+type sensorsOutput map[string]map[string]map[string]float64
+
+// readSensorsJSON shells out to lm-sensors and aggregates every *_input value
+// under a feature whose name starts with "temp" (case-insensitive), as a fallback for machines
+// without a usable hwmon sysfs tree (e.g. inside some containers).
+func readSensorsJSON(aggregateMax bool) (int, []int, bool) {
+	output, err := exec.Command("sensors", "-j").Output()
+	if err != nil {
+		return 0, nil, false
+	}
+
+	var parsed sensorsOutput
+	if err := json.Unmarshal(output, &parsed); err != nil {
+		return 0, nil, false
+	}
+
+	var readings []int
+	for _, chip := range parsed {
+		for feature, values := range chip {
+			if !strings.HasPrefix(strings.ToLower(feature), "temp") {
+				continue
+			}
+			for sub, v := range values {
+				if strings.HasSuffix(sub, "_input") {
+					readings = append(readings, int(v))
+				}
+			}
+		}
+	}
+
+	if len(readings) == 0 {
+		return 0, nil, false
+	}
+	return aggregate(readings, aggregateMax), readings, true
+}
@@ -0,0 +1,9 @@
+//go:build !linux && !darwin && !windows && !freebsd
+
+package system
+
+// getCPUTemperature has no implementation on this platform; GetSystemInfo
+// leaves CPUTemp blank when this returns 0.
+func getCPUTemperature(_ bool) (int, []int) {
+	return 0, nil
+}
@@ -0,0 +1,60 @@
+//go:build windows
+
+package system
+
+import (
+	"os/exec"
+	"strconv"
+	"strings"
+)
+
+// getCPUTemperature queries WMI's MSAcpi_ThermalZoneTemperature, which
+// reports in tenths of a Kelvin, and converts every zone found to Celsius.
+// Many consumer boards don't expose this namespace, in which case we return
+// 0 and let the UI hide the line.
+func getCPUTemperature(aggregateMax bool) (int, []int) {
+	output, err := exec.Command("wmic", "/namespace:\\\\root\\wmi", "PATH",
+		"MSAcpi_ThermalZoneTemperature", "get", "CurrentTemperature", "/value").Output()
+	if err != nil {
+		return 0, nil
+	}
+
+	var zones []int
+	for _, line := range strings.Split(string(output), "\n") {
+		line = strings.TrimSpace(line)
+		if !strings.HasPrefix(line, "CurrentTemperature=") {
+			continue
+		}
+		raw := strings.TrimPrefix(line, "CurrentTemperature=")
+		tenthsKelvin, err := strconv.Atoi(raw)
+		if err != nil {
+			continue
+		}
+		celsius := float64(tenthsKelvin)/10.0 - 273.15
+		zones = append(zones, int(celsius))
+	}
+
+	if len(zones) == 0 {
+		return 0, nil
+	}
+	return aggregate(zones, aggregateMax), zones
+}
+
+// aggregate collapses vals to a single headline reading: the maximum when
+// aggregateMax is set, otherwise the average.
+func aggregate(vals []int, aggregateMax bool) int {
+	if aggregateMax {
+		max := vals[0]
+		for _, v := range vals[1:] {
+			if v > max {
+				max = v
+			}
+		}
+		return max
+	}
+	sum := 0
+	for _, v := range vals {
+		sum += v
+	}
+	return sum / len(vals)
+}
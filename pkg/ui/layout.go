@@ -1,24 +1,85 @@
 package ui
 
 import (
+	"context"
 	"fmt"
 	"strings"
+	"sync"
 	"time"
 
 	"github.com/gdamore/tcell/v2"
+	"github.com/nacin/nacin-os/pkg/config"
 	"github.com/nacin/nacin-os/pkg/system"
 	"github.com/rivo/tview"
 )
 
 // UI holds all UI components
 type UI struct {
-	app        *tview.Application
-	mainFlex   *tview.Flex
-	header     *tview.TextView
-	middle     *tview.TextView
-	footer     *tview.TextView
-	stats      *tview.TextView
-	bottomFlex *tview.Flex // Added new field for bottom section
+	app      *tview.Application
+	cfg      *config.Config
+	mainFlex *tview.Flex
+	panels   map[config.Panel]*tview.TextView
+	sched    *scheduler
+	cancel   context.CancelFunc
+
+	collectors []system.Collector
+	showUsers  bool
+
+	activeMu sync.RWMutex
+	active   int
+
+	// snapMu guards a single cached SystemInfo shared by every panel's
+	// Monitor for one refresh cycle, so switching hosts or ticking over
+	// doesn't trigger one real collection per panel (see collect in
+	// monitors_system.go).
+	snapMu   sync.Mutex
+	snapAt   time.Time
+	snapHost string
+	snap     system.SystemInfo
+}
+
+// getActive returns the index of the collector currently shown.
+func (ui *UI) getActive() int {
+	ui.activeMu.RLock()
+	defer ui.activeMu.RUnlock()
+	return ui.active
+}
+
+// setActive changes which collector is shown, wrapping around collectors.
+func (ui *UI) setActive(delta int) {
+	ui.activeMu.Lock()
+	defer ui.activeMu.Unlock()
+	n := len(ui.collectors)
+	ui.active = ((ui.active+delta)%n + n) % n
+}
+
+// palette is the foreground/background color pair a panel renders with.
+type palette struct {
+	fg, bg tcell.Color
+}
+
+// headerPalette returns the header's colors for a color scheme.
+func headerPalette(scheme config.Scheme) palette {
+	switch scheme {
+	case config.SchemeMono:
+		return palette{fg: tcell.ColorWhite, bg: tcell.ColorBlack}
+	case config.SchemeHighContrast:
+		return palette{fg: tcell.ColorBlack, bg: tcell.ColorWhite}
+	default: // yellow-dark
+		return palette{fg: tcell.ColorWhite, bg: tcell.ColorDarkSlateGray}
+	}
+}
+
+// panelPalette returns the colors every other panel renders with.
+func panelPalette(scheme config.Scheme) palette {
+	switch scheme {
+	case config.SchemeMono:
+		return palette{fg: tcell.ColorWhite, bg: tcell.ColorBlack}
+	case config.SchemeHighContrast:
+		return palette{fg: tcell.ColorBlack, bg: tcell.ColorWhite}
+	default: // yellow-dark
+		return palette{fg: tcell.ColorBlack, bg: tcell.ColorYellow}
+	}
 }
 
 // createTextView creates a new text view with specified properties
@@ -35,67 +96,101 @@ func createTextView(color tcell.Color, bgColor tcell.Color, align int) *tview.Te
 	return tv
 }
 
-// NewUI creates a new UI instance and sets up the entire UI
+// NewUI creates a new UI instance showing only the local machine, using the
+// dashboard's historical default layout.
 func NewUI() *UI {
+	return NewUIWithConfig(config.Default(), nil, false, false, false)
+}
+
+// NewUIWithCollectors creates a new UI instance that pages through the
+// collectors one at a time, using the historical default layout. Tab/Shift+Tab
+// cycle the active host.
+func NewUIWithCollectors(collectors []system.Collector, showUsers, includeIPv6, aggregateTempMax bool) *UI {
+	return NewUIWithConfig(config.Default(), collectors, showUsers, includeIPv6, aggregateTempMax)
+}
+
+// NewUIWithConfig creates a new UI instance whose panels, flex weights,
+// refresh interval, and color scheme come from cfg, so each exam center can
+// customize the layout without recompiling. showUsers mirrors --show-users,
+// includeIPv6 mirrors --ipv6, and aggregateTempMax mirrors --temp-max-core.
+func NewUIWithConfig(cfg *config.Config, collectors []system.Collector, showUsers, includeIPv6, aggregateTempMax bool) *UI {
+	if cfg == nil {
+		cfg = config.Default()
+	}
+	if len(collectors) == 0 {
+		collectors = []system.Collector{system.NewLocalCollector("localhost", showUsers, includeIPv6, aggregateTempMax)}
+	}
+
 	app := tview.NewApplication()
 	mainFlex := tview.NewFlex().SetDirection(tview.FlexRow)
 
-	// Define colors - using high contrast colors only
-	darkGray := tcell.ColorDarkSlateGray
-	yellow := tcell.ColorYellow
-	white := tcell.ColorWhite
-	black := tcell.ColorBlack
-
-	// Create header
-	header := createTextView(white, darkGray, tview.AlignLeft)
-
-	// Create stats panel for system usage - use black text on yellow for contrast
-	stats := createTextView(black, yellow, tview.AlignRight)
-	stats.SetWordWrap(true) // Enable word wrap for better space utilization
-
-	// Create header flex without stats
-	headerFlex := tview.NewFlex().SetDirection(tview.FlexColumn)
-	headerFlex.AddItem(tview.NewBox().SetBackgroundColor(darkGray), 2, 0, false)
-	headerFlex.AddItem(header, 0, 1, false)
-	headerFlex.AddItem(tview.NewBox().SetBackgroundColor(darkGray), 2, 0, false)
-	headerFlex.SetBackgroundColor(darkGray)
-
-	// Create middle section for IP addresses
-	middle := createTextView(black, yellow, tview.AlignLeft)
-	middle.SetWordWrap(true) // Enable word wrap for better space utilization
-
-	// Create bottom section with IP addresses on left and stats on right
-	bottomFlex := tview.NewFlex().SetDirection(tview.FlexColumn)
-	bottomFlex.AddItem(tview.NewBox().SetBackgroundColor(yellow), 2, 0, false) // Restored original padding
-	bottomFlex.AddItem(middle, 0, 2, false)
-	bottomFlex.AddItem(stats, 0, 1, false)
-	bottomFlex.AddItem(tview.NewBox().SetBackgroundColor(yellow), 2, 0, false) // Restored original padding
-	bottomFlex.SetBackgroundColor(yellow)
-
-	// Add all sections to the main flex layout
-	mainFlex.AddItem(headerFlex, 0, 12, false)
-	mainFlex.AddItem(bottomFlex, 0, 14, false) // Further reduced from 18 to 14 to make the yellow section even shorter
+	panels := make(map[config.Panel]*tview.TextView, len(cfg.Panels))
+	for _, configRow := range cfg.Rows() {
+		rowPal := panelPalette(cfg.Colors)
+		if configRow.Panels[0].Name == config.PanelHeader {
+			rowPal = headerPalette(cfg.Colors)
+		}
+
+		rowFlex := tview.NewFlex().SetDirection(tview.FlexColumn)
+		rowFlex.AddItem(tview.NewBox().SetBackgroundColor(rowPal.bg), 2, 0, false)
+
+		for _, p := range configRow.Panels {
+			pal := panelPalette(cfg.Colors)
+			align := tview.AlignLeft
+			if p.Name == config.PanelHeader {
+				pal = headerPalette(cfg.Colors)
+			}
+			if p.Name == config.PanelStats {
+				align = tview.AlignRight
+			}
+
+			tv := createTextView(pal.fg, pal.bg, align)
+			tv.SetWordWrap(true)
+			panels[p.Name] = tv
+
+			rowFlex.AddItem(tv, 0, p.Weight, false)
+		}
+
+		rowFlex.AddItem(tview.NewBox().SetBackgroundColor(rowPal.bg), 2, 0, false)
+		rowFlex.SetBackgroundColor(rowPal.bg)
+
+		mainFlex.AddItem(rowFlex, 0, configRow.Weight, false)
+	}
 
 	ui := &UI{
 		app:        app,
+		cfg:        cfg,
 		mainFlex:   mainFlex,
-		header:     header,
-		middle:     middle,
-		footer:     nil, // No footer needed anymore
-		stats:      stats,
-		bottomFlex: bottomFlex,
+		panels:     panels,
+		sched:      newScheduler(),
+		collectors: collectors,
+		showUsers:  showUsers,
 	}
 
 	// Setup key handling
 	ui.app.SetInputCapture(func(event *tcell.EventKey) *tcell.EventKey {
-		if event.Key() == tcell.KeyEscape || event.Key() == tcell.KeyCtrlC {
+		switch {
+		case event.Key() == tcell.KeyEscape || event.Key() == tcell.KeyCtrlC:
 			ui.app.Stop()
+		case event.Key() == tcell.KeyTab:
+			ui.setActive(1)
+		case event.Key() == tcell.KeyBacktab:
+			ui.setActive(-1)
 		}
 		return event
 	})
 
-	// Start updating system information
-	go ui.updateSystemInfoPeriodically()
+	// Each panel is driven by its own Monitor on its own goroutine; after
+	// every render, every panel's TextView is reset to its latest text.
+	ctx, cancel := context.WithCancel(context.Background())
+	ui.cancel = cancel
+	ui.sched.start(ctx, ui.buildMonitors(cfg), func() {
+		ui.app.QueueUpdateDraw(func() {
+			for name, tv := range ui.panels {
+				tv.SetText(ui.sched.get(string(name)))
+			}
+		})
+	})
 
 	return ui
 }
@@ -112,48 +207,8 @@ func createEnhancedTitle(title string) string {
 	return enhancedTitle
 }
 
-// updateSystemInfoPeriodically updates the UI with system information every 1 second
-func (ui *UI) updateSystemInfoPeriodically() {
-	for {
-		ui.app.QueueUpdateDraw(func() {
-			info := system.GetSystemInfo()
-
-			// Create a slightly enhanced title
-			enhancedTitle := createEnhancedTitle("NACIN EXAM SERVER")
-
-			// Get current year for copyright
-			copyrightText := "Made by Sar Infocom"
-
-			// Update header text with the enhanced title and other information
-			ui.header.SetText(fmt.Sprintf("\n[::b]%s[::]\n[::b]%s[::]\n\n[::b]%s[::]\n[::b]%s[::]\n[::b]%s[::]\n[::b]%s[::]\n\n",
-				enhancedTitle,
-				copyrightText,
-				info.CPUInfo,
-				info.MemoryInfo,
-				info.GPUInfo,
-				info.UptimeInfo))
-
-			// Update stats panel in the bottom yellow section with bold text - more compact
-			ui.stats.SetText(fmt.Sprintf("\n[::b]%s[::]\n[::b]%s[::]\n[::b]%s[::]\n[::b]%s[::]",
-				info.CPUUsage,
-				info.RAMUsage,
-				info.CPUTemp,
-				info.GPUTemp))
-
-			// Update middle text for IP addresses with bold text - back to multi-line format
-			ipText := "\n[::b]IP addresses:[::]\n"
-			for _, ip := range info.IPAddresses {
-				ipText += fmt.Sprintf("[::b]%s[::]\n", ip)
-			}
-
-			ui.middle.SetText(ipText)
-		})
-
-		time.Sleep(900 * time.Millisecond)
-	}
-}
-
-// Run runs the UI application
+// Run runs the UI application until the user quits, then stops its monitors.
 func (ui *UI) Run() error {
+	defer ui.cancel()
 	return ui.app.SetRoot(ui.mainFlex, true).EnableMouse(false).Run()
 }
@@ -0,0 +1,74 @@
+package ui
+
+import (
+	"context"
+	"time"
+)
+
+// Monitor is a single metric widget: it renders its own display text on
+// demand and declares how often the scheduler should refresh it. New
+// metrics are added by writing a Monitor, not by touching layout code.
+type Monitor interface {
+	// Alias names the monitor; it keys its panel and redraw state.
+	Alias() string
+	// Rate is how often the scheduler should call Render.
+	Rate() time.Duration
+	// Render returns the monitor's current display text.
+	Render(ctx context.Context) (string, error)
+}
+
+// funcMonitor adapts a plain render function into a Monitor.
+type funcMonitor struct {
+	alias string
+	rate  time.Duration
+	fn    func(ctx context.Context) (string, error)
+}
+
+// NewMonitor builds a Monitor out of a render function, the common case for
+// a single metric.
+func NewMonitor(alias string, rate time.Duration, fn func(ctx context.Context) (string, error)) Monitor {
+	return &funcMonitor{alias: alias, rate: rate, fn: fn}
+}
+
+func (m *funcMonitor) Alias() string                              { return m.alias }
+func (m *funcMonitor) Rate() time.Duration                        { return m.rate }
+func (m *funcMonitor) Render(ctx context.Context) (string, error) { return m.fn(ctx) }
+
+// AltMonitor renders b only when a renders an empty string, e.g. showing
+// GPUInfo only when nvidia-smi succeeds and falling back to "Integrated
+// Graphics" otherwise. It runs on a's alias and rate.
+func AltMonitor(a, b Monitor) Monitor {
+	return NewMonitor(a.Alias(), a.Rate(), func(ctx context.Context) (string, error) {
+		out, err := a.Render(ctx)
+		if err != nil {
+			return "", err
+		}
+		if out != "" {
+			return out, nil
+		}
+		return b.Render(ctx)
+	})
+}
+
+// ConcatMonitor joins a's and b's rendered text with sep, skipping either
+// side when it renders empty. It runs on a's alias and rate.
+func ConcatMonitor(sep string, a, b Monitor) Monitor {
+	return NewMonitor(a.Alias(), a.Rate(), func(ctx context.Context) (string, error) {
+		left, err := a.Render(ctx)
+		if err != nil {
+			return "", err
+		}
+		right, err := b.Render(ctx)
+		if err != nil {
+			return "", err
+		}
+		switch {
+		case left == "":
+			return right, nil
+		case right == "":
+			return left, nil
+		default:
+			return left + sep + right, nil
+		}
+	})
+}
@@ -0,0 +1,199 @@
+package ui
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/nacin/nacin-os/pkg/config"
+	"github.com/nacin/nacin-os/pkg/system"
+)
+
+// collect returns the latest SystemInfo snapshot from the active collector,
+// substituting an error message if collection fails. Every panel's Monitor
+// calls this on its own goroutine, so it caches one snapshot per refresh
+// interval instead of running a fresh collection (and, for the local
+// collector, a fresh getInterfaceStats rate sample) per panel per tick.
+func (ui *UI) collect() (system.SystemInfo, system.Collector) {
+	collector := ui.collectors[ui.getActive()]
+
+	ui.snapMu.Lock()
+	defer ui.snapMu.Unlock()
+	if collector.Host() == ui.snapHost && time.Since(ui.snapAt) < ui.cfg.Refresh() {
+		return ui.snap, collector
+	}
+
+	info, err := collector.Collect()
+	if err != nil {
+		info = system.SystemInfo{OSInfo: fmt.Sprintf("%s: %v", collector.Host(), err)}
+	}
+	ui.snap = info
+	ui.snapAt = time.Now()
+	ui.snapHost = collector.Host()
+	return info, collector
+}
+
+// buildMonitors turns cfg's panel list into the Monitor set the scheduler
+// drives. Adding a new panel means adding a case here, not touching the
+// flex layout code in NewUIWithConfig.
+func (ui *UI) buildMonitors(cfg *config.Config) []Monitor {
+	rate := cfg.Refresh()
+	monitors := make([]Monitor, 0, len(cfg.Panels))
+
+	for _, p := range cfg.Panels {
+		switch p.Name {
+		case config.PanelHeader:
+			monitors = append(monitors, ui.headerMonitor(rate))
+		case config.PanelStats:
+			monitors = append(monitors, ui.statsMonitor(rate))
+		case config.PanelIPs:
+			monitors = append(monitors, ui.ipsMonitor(rate))
+		case config.PanelTemps:
+			monitors = append(monitors, ui.tempsMonitor(rate))
+		case config.PanelLoad:
+			monitors = append(monitors, ui.loadMonitor(rate))
+		case config.PanelTopProcs:
+			monitors = append(monitors, ui.topProcsMonitor(rate))
+		case config.PanelNetwork:
+			monitors = append(monitors, ui.networkMonitor(rate))
+		}
+	}
+	return monitors
+}
+
+// headerMonitor renders the title, copyright, and core machine info, with
+// its GPU line composed from an NVIDIA probe that falls back to
+// "Integrated Graphics" when nvidia-smi isn't available.
+func (ui *UI) headerMonitor(rate time.Duration) Monitor {
+	core := NewMonitor(string(config.PanelHeader), rate, func(ctx context.Context) (string, error) {
+		info, collector := ui.collect()
+
+		copyrightText := "Made by Sar Infocom"
+		if len(ui.collectors) > 1 {
+			copyrightText = fmt.Sprintf("%s  [%d/%d %s, Tab to switch]", copyrightText, ui.getActive()+1, len(ui.collectors), collector.Host())
+		}
+
+		return fmt.Sprintf("\n[::b]%s[::]\n[::b]%s[::]\n\n[::b]%s[::]\n[::b]%s[::]\n[::b]%s[::]",
+			createEnhancedTitle(ui.cfg.Title),
+			copyrightText,
+			info.CPUInfo,
+			info.MemoryInfo,
+			info.UptimeInfo), nil
+	})
+
+	gpu := AltMonitor(ui.nvidiaGPUMonitor(rate), ui.integratedGraphicsMonitor(rate))
+	return ConcatMonitor("\n", core, gpu)
+}
+
+// nvidiaGPUMonitor renders the NVIDIA GPU name, or "" if nvidia-smi isn't
+// available — meant to be composed via AltMonitor with a fallback.
+func (ui *UI) nvidiaGPUMonitor(rate time.Duration) Monitor {
+	return NewMonitor("gpu", rate, func(ctx context.Context) (string, error) {
+		name := system.NvidiaGPUInfo()
+		if name == "" {
+			return "", nil
+		}
+		return fmt.Sprintf("[::b]%s[::]", name), nil
+	})
+}
+
+// integratedGraphicsMonitor is the AltMonitor fallback for nvidiaGPUMonitor.
+func (ui *UI) integratedGraphicsMonitor(rate time.Duration) Monitor {
+	return NewMonitor("gpu-fallback", rate, func(ctx context.Context) (string, error) {
+		return "[::b]Integrated Graphics[::]", nil
+	})
+}
+
+func (ui *UI) statsMonitor(rate time.Duration) Monitor {
+	return NewMonitor(string(config.PanelStats), rate, func(ctx context.Context) (string, error) {
+		info, _ := ui.collect()
+		text := ""
+		// CPUTemp and GPUTemp are blank when no sensor is available; skip
+		// their lines rather than leave empty ones.
+		for _, line := range []string{info.CPUUsage, info.RAMUsage, info.CPUTemp, info.GPUTemp,
+			system.FormatUptimeLine(info, ui.showUsers), fmt.Sprintf("Processes: %d", info.NumProcs)} {
+			if line != "" {
+				text += fmt.Sprintf("\n[::b]%s[::]", line)
+			}
+		}
+		return text, nil
+	})
+}
+
+func (ui *UI) ipsMonitor(rate time.Duration) Monitor {
+	return NewMonitor(string(config.PanelIPs), rate, func(ctx context.Context) (string, error) {
+		info, _ := ui.collect()
+		text := "\n[::b]IP addresses:[::]\n"
+		for _, ip := range info.IPAddresses {
+			text += fmt.Sprintf("[::b]%s[::]\n", ip)
+		}
+		return text, nil
+	})
+}
+
+func (ui *UI) tempsMonitor(rate time.Duration) Monitor {
+	return NewMonitor(string(config.PanelTemps), rate, func(ctx context.Context) (string, error) {
+		info, _ := ui.collect()
+		text := "\n"
+		for _, line := range []string{info.CPUTemp, info.GPUTemp} {
+			if line != "" {
+				text += fmt.Sprintf("[::b]%s[::]\n", line)
+			}
+		}
+		return text, nil
+	})
+}
+
+func (ui *UI) loadMonitor(rate time.Duration) Monitor {
+	return NewMonitor(string(config.PanelLoad), rate, func(ctx context.Context) (string, error) {
+		info, _ := ui.collect()
+		return fmt.Sprintf("\n[::b]%s[::]\n", system.FormatUptimeLine(info, ui.showUsers)), nil
+	})
+}
+
+func (ui *UI) topProcsMonitor(rate time.Duration) Monitor {
+	return NewMonitor(string(config.PanelTopProcs), rate, func(ctx context.Context) (string, error) {
+		info, _ := ui.collect()
+		return fmt.Sprintf("\n[::b]Running processes: %d[::]\n", info.NumProcs), nil
+	})
+}
+
+// networkMonitor renders each non-loopback interface's IPv4 (and, behind
+// --ipv6, IPv6) addresses, MAC, and throughput, e.g.
+// "eth0 (10.0.0.5) aa:bb:cc:dd:ee:ff  ↓ 1.2 MB/s ↑ 340.0 KB/s".
+func (ui *UI) networkMonitor(rate time.Duration) Monitor {
+	return NewMonitor(string(config.PanelNetwork), rate, func(ctx context.Context) (string, error) {
+		info, _ := ui.collect()
+
+		text := "\n[::b]Network:[::]\n"
+		for _, iface := range info.Interfaces {
+			addrs := strings.Join(iface.IPv4, ", ")
+			if len(iface.IPv6) > 0 {
+				if addrs != "" {
+					addrs += ", "
+				}
+				addrs += strings.Join(iface.IPv6, ", ")
+			}
+			if addrs == "" {
+				addrs = "no address"
+			}
+
+			text += fmt.Sprintf("[::b]%s[::] (%s) %s  ↓ %s/s ↑ %s/s\n",
+				iface.Name, addrs, iface.MAC, formatBytes(iface.RxRate), formatBytes(iface.TxRate))
+		}
+		return text, nil
+	})
+}
+
+// formatBytes renders a byte count (or byte/sec rate) using the smallest
+// unit that keeps the number under 1000, e.g. "1.2 MB".
+func formatBytes(n float64) string {
+	units := []string{"B", "KB", "MB", "GB", "TB"}
+	i := 0
+	for n >= 1000 && i < len(units)-1 {
+		n /= 1000
+		i++
+	}
+	return fmt.Sprintf("%.1f %s", n, units[i])
+}
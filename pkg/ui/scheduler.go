@@ -0,0 +1,66 @@
+package ui
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+)
+
+// scheduler runs each Monitor on its own goroutine at its own Rate and
+// keeps the latest rendered text per alias, replacing the old single
+// shared-cadence update loop.
+type scheduler struct {
+	mu     sync.RWMutex
+	latest map[string]string
+}
+
+// newScheduler creates an empty scheduler.
+func newScheduler() *scheduler {
+	return &scheduler{latest: make(map[string]string)}
+}
+
+// start launches one goroutine per monitor, calling onUpdate after every
+// render so the caller can redraw. It returns at once; the goroutines stop
+// once ctx is cancelled.
+func (s *scheduler) start(ctx context.Context, monitors []Monitor, onUpdate func()) {
+	for _, m := range monitors {
+		go s.run(ctx, m, onUpdate)
+	}
+}
+
+func (s *scheduler) run(ctx context.Context, m Monitor, onUpdate func()) {
+	s.render(ctx, m, onUpdate)
+
+	ticker := time.NewTicker(m.Rate())
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			s.render(ctx, m, onUpdate)
+		}
+	}
+}
+
+func (s *scheduler) render(ctx context.Context, m Monitor, onUpdate func()) {
+	text, err := m.Render(ctx)
+	if err != nil {
+		text = fmt.Sprintf("%s: error: %v", m.Alias(), err)
+	}
+
+	s.mu.Lock()
+	s.latest[m.Alias()] = text
+	s.mu.Unlock()
+
+	onUpdate()
+}
+
+// get returns the latest rendered text for alias, or "" if it hasn't
+// rendered yet.
+func (s *scheduler) get(alias string) string {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return s.latest[alias]
+}